@@ -0,0 +1,96 @@
+// Command greet is a small CLI wrapper around the greetings package. It
+// can greet a single name or, with -count, a batch of generated names,
+// in any of the package's supported locales.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/Raman-79/demo/greetings"
+)
+
+func main() {
+	var (
+		name   string
+		locale string
+		count  int
+		seed   int64
+		format string
+	)
+
+	flag.StringVar(&name, "name", "everyone", "name to greet")
+	flag.StringVar(&locale, "locale", "en", "locale to greet in (en, fr, ja)")
+	flag.IntVar(&count, "count", 1, "number of names to greet, e.g. name1..nameN")
+	flag.Int64Var(&seed, "seed", time.Now().UnixNano(), "seed for random format selection, for reproducible output")
+	flag.StringVar(&format, "format", "plain", "output format: plain or json")
+	flag.Parse()
+
+	messages, err := run(name, locale, count, seed)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if err := emit(os.Stdout, format, messages); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// run greets either a single name (count <= 1) or a batch of names
+// derived from name ("name1", "name2", ... "nameN"), returning a map
+// from name to greeting.
+func run(name, locale string, count int, seed int64) (map[string]string, error) {
+	g := greetings.NewGreeterWithSeed(locale, nil, seed)
+
+	if count <= 1 {
+		message, err := g.Hello(name)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]string{name: message}, nil
+	}
+
+	messages := make(map[string]string, count)
+	var errs []error
+	for i := 1; i <= count; i++ {
+		batchName := fmt.Sprintf("%s%d", name, i)
+		message, err := g.Hello(batchName)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		messages[batchName] = message
+	}
+	return messages, errors.Join(errs...)
+}
+
+// emit writes messages to w in the requested format ("plain" or
+// "json"), in a stable order so scripted callers get reproducible
+// output across runs.
+func emit(w *os.File, format string, messages map[string]string) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(messages)
+	case "plain", "":
+		names := make([]string, 0, len(messages))
+		for name := range messages {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(w, "%s: %s\n", name, messages[name])
+		}
+		return nil
+	default:
+		return fmt.Errorf("greet: unknown -format %q (want plain or json)", format)
+	}
+}
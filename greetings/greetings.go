@@ -1,14 +1,203 @@
+// Package greetings provides functions to greet people in different
+// locales, with pluggable message formats and support for greeting
+// many people at once.
 package greetings
 
 import (
 	"errors"
 	"fmt"
+	"io"
+	"math/rand"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 )
 
-func Hello(name string) string {
-	if name == "" {
-		return errors.New("empty name")
+// Sentinel errors returned (wrapped) by this package. Callers can match
+// them with errors.Is instead of parsing error strings.
+var (
+	ErrEmptyName       = errors.New("empty name")
+	ErrNameTooLong     = errors.New("name too long")
+	ErrNameInvalid     = errors.New("name contains an invalid character")
+	ErrUnknownLocale   = errors.New("unknown locale")
+	ErrTemplateInvalid = errors.New("invalid template")
+)
+
+// maxNameLength is the longest name Validate accepts.
+const maxNameLength = 100
+
+// FormatProvider supplies the candidate greeting templates for a given
+// locale. Each template must contain exactly one %v verb for the name.
+// Callers can implement FormatProvider to register their own message
+// templates instead of relying on the built-in ones.
+type FormatProvider interface {
+	Templates(locale string) ([]string, error)
+}
+
+// defaultFormatProvider is the built-in FormatProvider used when none is
+// supplied to NewGreeter. It knows the "en", "fr" and "ja" locales.
+type defaultFormatProvider struct{}
+
+func (defaultFormatProvider) Templates(locale string) ([]string, error) {
+	templates, ok := defaultTemplates[locale]
+	if !ok {
+		return nil, fmt.Errorf("greetings: locale %q: %w", locale, ErrUnknownLocale)
+	}
+	return templates, nil
+}
+
+var defaultTemplates = map[string][]string{
+	"en": {
+		"Hi, %v. Welcome!",
+		"Great to see you, %v!",
+		"Hail, %v! Well met!",
+	},
+	"fr": {
+		"Salut, %v. Bienvenue !",
+		"Ravi de te voir, %v !",
+	},
+	"ja": {
+		"こんにちは、%vさん。ようこそ！",
+		"%vさん、会えて嬉しいです！",
+	},
+}
+
+// Greeter greets people in a configured locale, picking a random message
+// template on each call. The zero value is not usable; construct a
+// Greeter with NewGreeter.
+type Greeter struct {
+	locale   string
+	provider FormatProvider
+	rng      *rand.Rand
+}
+
+// NewGreeter returns a Greeter for the given locale. If provider is nil,
+// the built-in English, French and Japanese templates are used. The
+// random format selector is seeded per-instance so that Greeters used
+// concurrently don't share state.
+func NewGreeter(locale string, provider FormatProvider) *Greeter {
+	return NewGreeterWithSeed(locale, provider, time.Now().UnixNano())
+}
+
+// NewGreeterWithSeed is like NewGreeter but seeds the random format
+// selector explicitly, so that callers needing reproducible output
+// (tests, CLI -seed flags) can get the same greeting for the same seed.
+func NewGreeterWithSeed(locale string, provider FormatProvider, seed int64) *Greeter {
+	if provider == nil {
+		provider = defaultFormatProvider{}
+	}
+	return &Greeter{
+		locale:   locale,
+		provider: provider,
+		rng:      rand.New(rand.NewSource(seed)),
+	}
+}
+
+// Validate checks that name is usable as a greeting target: non-empty
+// after trimming whitespace, no longer than maxNameLength, and free of
+// control characters. It returns an error wrapping ErrEmptyName,
+// ErrNameTooLong or ErrNameInvalid depending on which check fails, so
+// callers can distinguish the failure with errors.Is.
+func Validate(name string) error {
+	trimmed := strings.TrimSpace(name)
+	if trimmed == "" {
+		return fmt.Errorf("greetings.Validate(%q): %w", name, ErrEmptyName)
+	}
+	if utf8.RuneCountInString(trimmed) > maxNameLength {
+		return fmt.Errorf("greetings.Validate(%q): longer than %d characters: %w", name, maxNameLength, ErrNameTooLong)
+	}
+	for _, r := range name {
+		if unicode.IsControl(r) {
+			return fmt.Errorf("greetings.Validate(%q): contains a control character: %w", name, ErrNameInvalid)
+		}
+	}
+	return nil
+}
+
+// Hello returns a greeting for the named person, chosen at random from
+// the Greeter's locale templates.
+func (g *Greeter) Hello(name string) (string, error) {
+	if err := Validate(name); err != nil {
+		return "", fmt.Errorf("greetings.Hello(%q): %w", name, err)
+	}
+
+	templates, err := g.provider.Templates(g.locale)
+	if err != nil {
+		return "", fmt.Errorf("greetings.Hello(%q): %w", name, err)
+	}
+	if len(templates) == 0 {
+		return "", fmt.Errorf("greetings.Hello(%q): locale %q: %w", name, g.locale, ErrTemplateInvalid)
+	}
+
+	template := templates[g.rng.Intn(len(templates))]
+	return fmt.Sprintf(template, name), nil
+}
+
+// Hellos greets each of the given names using a default English Greeter,
+// returning a map from name to greeting. Errors encountered for
+// individual names are aggregated with errors.Join rather than aborting
+// the whole batch.
+func Hellos(names []string) (map[string]string, error) {
+	g := NewGreeter("en", nil)
+
+	messages := make(map[string]string)
+	var errs []error
+	for _, name := range names {
+		message, err := g.Hello(name)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		messages[name] = message
+	}
+
+	return messages, errors.Join(errs...)
+}
+
+// HelloTo writes a greeting for the named person to w and returns the
+// number of bytes written, as per io.Writer conventions. It lets callers
+// compose the greetings package with anything that accepts an
+// io.Writer - stdout, an http.ResponseWriter, or a bytes.Buffer in tests.
+// The greeting is first formatted into a string by Greeter.Hello and then
+// written to w; it is not formatted into w directly.
+func HelloTo(w io.Writer, name string) (int, error) {
+	return helloTo(w, NewGreeter("en", nil), name)
+}
+
+// helloTo writes a single greeting from g to w, so that callers greeting
+// many names (GreetAll) can share one Greeter - and one seeded
+// *rand.Rand - instead of constructing a fresh one per name.
+func helloTo(w io.Writer, g *Greeter, name string) (int, error) {
+	message, err := g.Hello(name)
+	if err != nil {
+		return 0, err
+	}
+	return fmt.Fprintf(w, "%s", message)
+}
+
+// GreetAll writes a greeting for each of the given names to w, one per
+// line. It stops and returns the first error encountered, whether from
+// greeting a name or from writing to w.
+func GreetAll(w io.Writer, names []string) error {
+	g := NewGreeter("en", nil)
+	for _, name := range names {
+		if _, err := helloTo(w, g, name); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintln(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Hello returns a greeting for the named person using the default
+// English templates.
+func Hello(name string) (string, error) {
+	var buf strings.Builder
+	if _, err := HelloTo(&buf, name); err != nil {
+		return "", err
 	}
-	message := fmt.Sprintf("Hi, %v. Welcome!", name)
-	return message
+	return buf.String(), nil
 }
@@ -0,0 +1,76 @@
+package greetings
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr error
+	}{
+		{"empty", "", ErrEmptyName},
+		{"whitespace only", "   ", ErrEmptyName},
+		{"too long", strings.Repeat("a", maxNameLength+1), ErrNameTooLong},
+		{"control character", "Glad\x07ys", ErrNameInvalid},
+		{"valid", "Gladys", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.input)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("Validate(%q) = %v, want nil", tt.input, err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Validate(%q) = %v, want error wrapping %v", tt.input, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGreeterHello_UnknownLocale(t *testing.T) {
+	g := NewGreeter("xx", nil)
+	if _, err := g.Hello("Gladys"); !errors.Is(err, ErrUnknownLocale) {
+		t.Fatalf("Hello() with unknown locale = %v, want error wrapping ErrUnknownLocale", err)
+	}
+}
+
+func TestGreeterHello_SeededDeterminism(t *testing.T) {
+	g1 := NewGreeterWithSeed("en", nil, 42)
+	g2 := NewGreeterWithSeed("en", nil, 42)
+
+	got1, err := g1.Hello("Gladys")
+	if err != nil {
+		t.Fatalf("Hello() error = %v", err)
+	}
+	got2, err := g2.Hello("Gladys")
+	if err != nil {
+		t.Fatalf("Hello() error = %v", err)
+	}
+
+	if got1 != got2 {
+		t.Fatalf("greetings from the same seed differ: %q vs %q", got1, got2)
+	}
+}
+
+func TestGreetAll(t *testing.T) {
+	var buf bytes.Buffer
+	names := []string{"Gladys", "Samantha"}
+
+	if err := GreetAll(&buf, names); err != nil {
+		t.Fatalf("GreetAll() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if len(lines) != len(names) {
+		t.Fatalf("GreetAll() wrote %d lines, want %d", len(lines), len(names))
+	}
+}